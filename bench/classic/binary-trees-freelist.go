@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+// flNode and freelist mirror binary-trees.go's tree shape but pull
+// nodes from a pre-slabbed backing array instead of allocating each
+// one individually, following the binary-trees-freelist variant from
+// the upstream Go shootout.
+type flNode struct {
+	left, right *flNode
+}
+
+type freelist struct {
+	nodes []flNode
+	next  int
+}
+
+func newFreelist(depth int) *freelist {
+	nodeCount := (1 << uint(depth+1)) - 1
+	return &freelist{nodes: make([]flNode, nodeCount)}
+}
+
+func (f *freelist) alloc() *flNode {
+	n := &f.nodes[f.next]
+	f.next++
+	return n
+}
+
+func flBottomUpTree(f *freelist, depth int) *flNode {
+	n := f.alloc()
+	if depth > 0 {
+		n.left = flBottomUpTree(f, depth-1)
+		n.right = flBottomUpTree(f, depth-1)
+	}
+	return n
+}
+
+func (n *flNode) itemCheck() int {
+	if n.left == nil {
+		return 1
+	}
+	return 1 + n.left.itemCheck() + n.right.itemCheck()
+}
+
+func main() {
+	n := flag.Int("n", 10, "max tree depth")
+	flag.Parse()
+
+	minDepth := 4
+	maxDepth := minDepth + 2
+	if maxDepth < *n+1 {
+		maxDepth = *n + 1
+	}
+
+	stretchDepth := maxDepth + 1
+	stretchFl := newFreelist(stretchDepth)
+	stretchTree := flBottomUpTree(stretchFl, stretchDepth)
+	fmt.Printf("stretch tree of depth %d\t check: %d\n", stretchDepth, stretchTree.itemCheck())
+
+	longLivedFl := newFreelist(maxDepth)
+	longLivedTree := flBottomUpTree(longLivedFl, maxDepth)
+
+	for depth := minDepth; depth <= maxDepth; depth += 2 {
+		iterations := 1 << uint(maxDepth-depth+minDepth)
+		check := 0
+		fl := newFreelist(depth)
+		for i := 0; i < iterations; i++ {
+			fl.next = 0
+			check += flBottomUpTree(fl, depth).itemCheck()
+		}
+		fmt.Printf("%d\t trees of depth %d\t check: %d\n", iterations, depth, check)
+	}
+
+	fmt.Printf("long lived tree of depth %d\t check: %d\n", maxDepth, longLivedTree.itemCheck())
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+// Node is a binary tree node allocated straight off the heap, one
+// `&Node{...}` per call. See binary-trees-freelist.go for a variant
+// that pre-slabs nodes instead, to compare allocator behavior under
+// the wasm target.
+type Node struct {
+	left, right *Node
+}
+
+func bottomUpTree(depth int) *Node {
+	if depth <= 0 {
+		return &Node{}
+	}
+	return &Node{bottomUpTree(depth - 1), bottomUpTree(depth - 1)}
+}
+
+func (n *Node) itemCheck() int {
+	if n.left == nil {
+		return 1
+	}
+	return 1 + n.left.itemCheck() + n.right.itemCheck()
+}
+
+func main() {
+	n := flag.Int("n", 10, "max tree depth")
+	flag.Parse()
+
+	minDepth := 4
+	maxDepth := minDepth + 2
+	if maxDepth < *n+1 {
+		maxDepth = *n + 1
+	}
+
+	stretchDepth := maxDepth + 1
+	stretchTree := bottomUpTree(stretchDepth)
+	fmt.Printf("stretch tree of depth %d\t check: %d\n", stretchDepth, stretchTree.itemCheck())
+
+	longLivedTree := bottomUpTree(maxDepth)
+
+	for depth := minDepth; depth <= maxDepth; depth += 2 {
+		iterations := 1 << uint(maxDepth-depth+minDepth)
+		check := 0
+		for i := 0; i < iterations; i++ {
+			check += bottomUpTree(depth).itemCheck()
+		}
+		fmt.Printf("%d\t trees of depth %d\t check: %d\n", iterations, depth, check)
+	}
+
+	fmt.Printf("long lived tree of depth %d\t check: %d\n", maxDepth, longLivedTree.itemCheck())
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+// chameneos-redux is a port of the classic chameneos-redux
+// concurrency benchmark: creatures of fixed colors repeatedly meet in
+// pairs at a shared meeting place and both recolor to the complement
+// of the pair, until `meetings` total meetings have happened.
+//
+// Wasm target note: see the scheduler comment in
+// fannkuch-parallel.go - this benchmark additionally exercises
+// `select`, so whichever scheduling strategy the module picks must
+// also support blocking on multiple channel operations at once, not
+// just a single send or receive.
+
+type color int
+
+const (
+	blue color = iota
+	red
+	yellow
+)
+
+func complement(a, b color) color {
+	if a == b {
+		return a
+	}
+	switch {
+	case a == blue && b == red, a == red && b == blue:
+		return yellow
+	case a == blue && b == yellow, a == yellow && b == blue:
+		return red
+	default:
+		return blue
+	}
+}
+
+type meeting struct {
+	color color
+	reply chan color
+}
+
+type meetingPlace struct {
+	meetings chan *meeting
+	done     chan struct{}
+}
+
+func newMeetingPlace(n int) *meetingPlace {
+	mp := &meetingPlace{
+		meetings: make(chan *meeting),
+		done:     make(chan struct{}),
+	}
+	go mp.run(n)
+	return mp
+}
+
+func (mp *meetingPlace) run(n int) {
+	meetingsLeft := n
+	var first *meeting
+	for meetingsLeft > 0 {
+		if first == nil {
+			first = <-mp.meetings
+			continue
+		}
+		second := <-mp.meetings
+		c := complement(first.color, second.color)
+		first.reply <- c
+		second.reply <- c
+		first = nil
+		meetingsLeft--
+	}
+	close(mp.done)
+}
+
+func creature(mp *meetingPlace, start color, meetingsDone chan<- int) {
+	c := start
+	count := 0
+	for {
+		reply := make(chan color)
+		select {
+		case mp.meetings <- &meeting{c, reply}:
+			c = <-reply
+			count++
+		case <-mp.done:
+			meetingsDone <- count
+			return
+		}
+	}
+}
+
+// chameneosRedux runs the creatures until `meetings` total meetings
+// have happened and returns the sum of meetings each creature took
+// part in - a fixed point regardless of scheduling order, since the
+// meeting place serializes pairing.
+func chameneosRedux(meetings int) int {
+	colors := []color{blue, red, yellow, blue, red}
+	mp := newMeetingPlace(meetings)
+	results := make(chan int, len(colors))
+	for _, c := range colors {
+		go creature(mp, c, results)
+	}
+
+	total := 0
+	for range colors {
+		total += <-results
+	}
+	return total
+}
+
+func main() {
+	n := flag.Int("n", 600, "number of meetings")
+	flag.Parse()
+	fmt.Println(chameneosRedux(*n))
+}
@@ -0,0 +1,20 @@
+package main
+
+import "testing"
+
+// Run with: go test chameneos-redux.go chameneos_redux_test.go
+
+// TestChameneosReduxDeterministic guards the meeting-place goroutine
+// pipeline: the meeting place serializes pairing, so the total
+// meeting count each creature reports back should sum to 2*meetings
+// every time regardless of select/scheduling order.
+func TestChameneosReduxDeterministic(t *testing.T) {
+	const meetings = 600
+	want := 2 * meetings
+	for i := 0; i < 20; i++ {
+		got := chameneosRedux(meetings)
+		if got != want {
+			t.Fatalf("run %d: chameneosRedux(%d) = %d, want %d", i, meetings, got, want)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sync"
+)
+
+// fannkuch-parallel computes the same checksum/maxFlips pair as the
+// serial fannkuch.go, parallelized across goroutines. The split has
+// to happen at Heap's-algorithm permutation boundaries, not at an
+// arbitrary lexicographic index: the checksum is a sum of *signed*
+// flip counts, and the sign alternates by each permutation's position
+// in fannkuch.go's own Heap's-order traversal, so any other
+// enumeration order assigns different signs to the same permutations
+// and produces a different (wrong) checksum.
+//
+// Heap's algorithm's top level loops over the n choices to rotate
+// into the last slot; branch i owns the contiguous index range
+// [i*(n-1)!, (i+1)*(n-1)!) in that traversal. Unlike a textbook
+// divide-and-conquer split, branch i's *starting* permutation isn't
+// derivable from i alone - Heap's algorithm doesn't restore the
+// leading elements between top-level iterations (that's only true for
+// even-length prefixes), so branch i+1's starting array depends on
+// every swap branch i's recursion performed. fannkuchParallel first
+// walks the top level serially with advanceLevel, which repeats that
+// same recursive swap pattern without the expensive flip() call, to
+// collect each branch's starting permutation; that pass costs O(n!)
+// cheap swaps against the O(n! * n) flip work it unlocks for
+// parallel execution, so it stays a lower-order cost. Only then does
+// it dispatch one goroutine per branch to run fannkuch.go's
+// unmodified recursion (permuteSubtree) over its own copy.
+//
+// Wasm target note: until wasm-threads + shared memory is wired up,
+// `go` statements here are expected to lower onto a single-threaded
+// cooperative scheduler that multiplexes goroutines within one wasm
+// instance, not onto real OS threads. Because the merge below only
+// sums/maxes independent partial results, output is expected to stay
+// identical whether the goroutines actually run concurrently (native
+// Go) or are cooperatively interleaved (that scheduler) - this
+// benchmark exists to make the module commit to one behavior instead
+// of silently ignoring `go`.
+
+func fkpFlip(p []int) int {
+	count := 0
+	perm := append([]int(nil), p...)
+	for perm[0] != 0 {
+		k := perm[0]
+		for i, j := 0, k; i < j; i, j = i+1, j-1 {
+			perm[i], perm[j] = perm[j], perm[i]
+		}
+		count++
+	}
+	return count
+}
+
+// advanceLevel repeats Heap's algorithm's swap schedule for the
+// leading k elements of perm without computing any flips, so callers
+// can walk to the permutation that would follow k! leaves of actual
+// work.
+func advanceLevel(perm []int, k int) {
+	if k == 1 {
+		return
+	}
+	for i := 0; i < k; i++ {
+		advanceLevel(perm, k-1)
+		if k%2 == 0 {
+			perm[i], perm[k-1] = perm[k-1], perm[i]
+		} else {
+			perm[0], perm[k-1] = perm[k-1], perm[0]
+		}
+	}
+}
+
+// permuteSubtree is fannkuch.go's permute(k) closure pulled out so a
+// branch can run it on its own perm slice starting from idx instead
+// of always starting fresh at k=n, idx=0.
+func permuteSubtree(perm []int, k int, idx int) (checksum, maxFlips int) {
+	var rec func(k int)
+	rec = func(k int) {
+		if k == 1 {
+			f := fkpFlip(perm)
+			if f > maxFlips {
+				maxFlips = f
+			}
+			if idx%2 == 0 {
+				checksum += f
+			} else {
+				checksum -= f
+			}
+			idx++
+			return
+		}
+		for i := 0; i < k; i++ {
+			rec(k - 1)
+			if k%2 == 0 {
+				perm[i], perm[k-1] = perm[k-1], perm[i]
+			} else {
+				perm[0], perm[k-1] = perm[k-1], perm[0]
+			}
+		}
+	}
+	rec(k)
+	return
+}
+
+type fkResult struct {
+	checksum, maxFlips int
+}
+
+func identity(n int) []int {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	return perm
+}
+
+// fannkuchParallel drives Heap's algorithm's top level itself to
+// collect each branch's starting permutation, then dispatches each of
+// the n sub-permutation-space branches to its own goroutine.
+func fannkuchParallel(n int) (int, int) {
+	if n < 2 {
+		return permuteSubtree(identity(n), n, 0)
+	}
+
+	branchSize := 1
+	for i := 2; i < n; i++ {
+		branchSize *= i
+	}
+
+	perm := identity(n)
+	branchStarts := make([][]int, n)
+	for i := 0; i < n; i++ {
+		branchStarts[i] = append([]int(nil), perm...)
+		advanceLevel(perm, n-1)
+		if n%2 == 0 {
+			perm[i], perm[n-1] = perm[n-1], perm[i]
+		} else {
+			perm[0], perm[n-1] = perm[n-1], perm[0]
+		}
+	}
+
+	results := make(chan fkResult, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		startIdx := i * branchSize
+		wg.Add(1)
+		go func(branchPerm []int, startIdx int) {
+			defer wg.Done()
+			checksum, maxFlips := permuteSubtree(branchPerm, n-1, startIdx)
+			results <- fkResult{checksum, maxFlips}
+		}(branchStarts[i], startIdx)
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var checksum, maxFlips int
+	for r := range results {
+		checksum += r.checksum
+		if r.maxFlips > maxFlips {
+			maxFlips = r.maxFlips
+		}
+	}
+	return checksum, maxFlips
+}
+
+func main() {
+	n := flag.Int("n", 7, "permutation size")
+	flag.Parse()
+	checksum, maxFlips := fannkuchParallel(*n)
+	fmt.Printf("%d\nPfannkuchen(%d) = %d\n", checksum, *n, maxFlips)
+}
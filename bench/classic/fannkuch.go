@@ -0,0 +1,64 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+func flip(p []int) int {
+	count := 0
+	perm := append([]int(nil), p...)
+	for perm[0] != 0 {
+		k := perm[0]
+		for i, j := 0, k; i < j; i, j = i+1, j-1 {
+			perm[i], perm[j] = perm[j], perm[i]
+		}
+		count++
+	}
+	return count
+}
+
+// fannkuch walks every permutation of 0..n-1 via Heap's algorithm,
+// folding each one's pancake-flip count into a checksum (alternating
+// sign by permutation index) and a running max.
+func fannkuch(n int) (checksum, maxFlips int) {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+
+	idx := 0
+	var permute func(k int)
+	permute = func(k int) {
+		if k == 1 {
+			f := flip(perm)
+			if f > maxFlips {
+				maxFlips = f
+			}
+			if idx%2 == 0 {
+				checksum += f
+			} else {
+				checksum -= f
+			}
+			idx++
+			return
+		}
+		for i := 0; i < k; i++ {
+			permute(k - 1)
+			if k%2 == 0 {
+				perm[i], perm[k-1] = perm[k-1], perm[i]
+			} else {
+				perm[0], perm[k-1] = perm[k-1], perm[0]
+			}
+		}
+	}
+	permute(n)
+	return
+}
+
+func main() {
+	n := flag.Int("n", 7, "permutation size")
+	flag.Parse()
+	checksum, maxFlips := fannkuch(*n)
+	fmt.Printf("%d\nPfannkuchen(%d) = %d\n", checksum, *n, maxFlips)
+}
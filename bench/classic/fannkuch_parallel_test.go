@@ -0,0 +1,47 @@
+package main
+
+import "testing"
+
+// Run with: go test fannkuch-parallel.go fannkuch_parallel_test.go
+// (fannkuch.go is excluded: both files declare their own func main,
+// so these standalone benchmarks can't be compiled together as a
+// package).
+//
+// 228/16 and 1616/22 are the canonical fannkuch-redux checksum and
+// max-flips-count for n=7 and n=8 - the same values fannkuch.go's
+// serial implementation produces (verified separately, since that
+// file can't be linked into this test binary). A mismatch here means
+// fannkuchParallel's branch split has drifted from Heap's-algorithm
+// order again.
+func TestFannkuchParallelMatchesCanonical(t *testing.T) {
+	cases := []struct {
+		n                  int
+		checksum, maxFlips int
+	}{
+		{7, 228, 16},
+		{8, 1616, 22},
+	}
+	for _, c := range cases {
+		checksum, maxFlips := fannkuchParallel(c.n)
+		if checksum != c.checksum || maxFlips != c.maxFlips {
+			t.Errorf("fannkuchParallel(%d) = (%d, %d), want (%d, %d)",
+				c.n, checksum, maxFlips, c.checksum, c.maxFlips)
+		}
+	}
+}
+
+// TestFannkuchParallelDeterministic guards against the goroutine
+// split introducing scheduling-order nondeterminism: every run must
+// fold to the same checksum/maxFlips regardless of how the branch
+// goroutines happen to interleave.
+func TestFannkuchParallelDeterministic(t *testing.T) {
+	const n = 8
+	wantChecksum, wantMaxFlips := fannkuchParallel(n)
+	for i := 0; i < 20; i++ {
+		checksum, maxFlips := fannkuchParallel(n)
+		if checksum != wantChecksum || maxFlips != wantMaxFlips {
+			t.Fatalf("run %d: fannkuchParallel(%d) = (%d, %d), want (%d, %d)",
+				i, n, checksum, maxFlips, wantChecksum, wantMaxFlips)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"os"
+)
+
+const lineLength = 60
+
+const alu = "GGCCGGGCGCGGTGGCTCACGCCTGTAATCCCAGCACTTTGGGAGGCCGAGGCGGGCGGATCACCTGAGGTCAGGAGTTCGAGACCAGCCTGGCCAACATGGTGAAACCCCGTCTCTACTAAAAATACAAAAATTAGCCGGGCGTGGTGGCGCGCGCCTGTAATCCCAGCTACTCGGGAGGCTGAGGCAGGAGAATCGCTTGAACCCGGGAGGCGGAGGTTGCAGTGAGCCGAGATCGCGCCACTGCACTCCAGCCTGGGCGACAGAGCGAGACTCCGTCTCAAAAAAA"
+
+type aminoAcid struct {
+	c    byte
+	prob float64
+}
+
+func cumulative(acids []aminoAcid) []aminoAcid {
+	var sum float64
+	out := make([]aminoAcid, len(acids))
+	for i, a := range acids {
+		sum += a.prob
+		out[i] = aminoAcid{a.c, sum}
+	}
+	return out
+}
+
+var iub = cumulative([]aminoAcid{
+	{'a', 0.27}, {'c', 0.12}, {'g', 0.12}, {'t', 0.27},
+	{'B', 0.02}, {'D', 0.02}, {'H', 0.02}, {'K', 0.02}, {'M', 0.02},
+	{'N', 0.02}, {'R', 0.02}, {'S', 0.02}, {'V', 0.02}, {'W', 0.02}, {'Y', 0.02},
+})
+
+var homoSapiens = cumulative([]aminoAcid{
+	{'a', 0.3029549426680}, {'c', 0.1979883004921},
+	{'g', 0.1975473066391}, {'t', 0.3015094502008},
+})
+
+const (
+	im = 139968
+	ia = 3877
+	ic = 29573
+)
+
+var seed = 42
+
+func nextRandom(max float64) float64 {
+	seed = (seed*ia + ic) % im
+	return max * float64(seed) / im
+}
+
+func repeatFasta(out *bufio.Writer, s string, n int) {
+	buf := []byte(s)
+	l := len(buf)
+	pos := 0
+	for n > 0 {
+		lineLen := lineLength
+		if n < lineLen {
+			lineLen = n
+		}
+		for i := 0; i < lineLen; i++ {
+			out.WriteByte(buf[pos])
+			pos++
+			if pos == l {
+				pos = 0
+			}
+		}
+		out.WriteByte('\n')
+		n -= lineLen
+	}
+}
+
+func randomFasta(out *bufio.Writer, acids []aminoAcid, n int) {
+	for n > 0 {
+		lineLen := lineLength
+		if n < lineLen {
+			lineLen = n
+		}
+		for i := 0; i < lineLen; i++ {
+			r := nextRandom(1.0)
+			j := 0
+			for acids[j].prob < r {
+				j++
+			}
+			out.WriteByte(acids[j].c)
+		}
+		out.WriteByte('\n')
+		n -= lineLen
+	}
+}
+
+func main() {
+	n := flag.Int("n", 1000, "number of bases to generate")
+	flag.Parse()
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	out.WriteString(">ONE Homo sapiens alu\n")
+	repeatFasta(out, alu, 2*(*n))
+
+	out.WriteString(">TWO IUB ambiguity codes\n")
+	randomFasta(out, iub, 3*(*n))
+
+	out.WriteString(">THREE Homo sapiens frequency\n")
+	randomFasta(out, homoSapiens, 5*(*n))
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// The linear congruential generator constants below match fasta.go's;
+// each classic/*.go file is run standalone (`go run k-nucleotide.go`),
+// so the generator is duplicated here rather than shared.
+const (
+	im = 139968
+	ia = 3877
+	ic = 29573
+)
+
+// generateSequence produces a deterministic pseudo-random DNA
+// sequence of length n using the same linear congruential generator
+// as fasta.go, so the benchmark is self-contained instead of reading
+// a FASTA file from stdin like the upstream k-nucleotide.go.
+func generateSequence(n int, seed int) []byte {
+	const bases = "ACGT"
+	seq := make([]byte, n)
+	s := seed
+	for i := 0; i < n; i++ {
+		s = (s*ia + ic) % im
+		// s%4 would pick the base from s's low bits alone, which
+		// degenerates here: ia and ic are both 1 mod 4, so s%4 just
+		// advances by a fixed +1 each step instead of varying.
+		// Bucketing the full [0,im) range into quarters uses the
+		// high bits instead, so the sequence is actually irregular.
+		seq[i] = bases[s*4/im]
+	}
+	return seq
+}
+
+func count(seq []byte, k int) map[string]int {
+	counts := make(map[string]int)
+	for i := 0; i+k <= len(seq); i++ {
+		counts[string(seq[i:i+k])]++
+	}
+	return counts
+}
+
+func writeFrequencies(seq []byte, k int) string {
+	counts := count(seq, k)
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	var b strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&b, "%s %.3f\n", key, 100*float64(counts[key])/float64(total))
+	}
+	return b.String()
+}
+
+func writeCount(seq []byte, s string) string {
+	counts := count(seq, len(s))
+	return fmt.Sprintf("%d\t%s\n", counts[s], s)
+}
+
+func main() {
+	n := flag.Int("n", 10000, "length of the generated sequence")
+	flag.Parse()
+
+	seq := generateSequence(*n, 42)
+
+	fmt.Print(writeFrequencies(seq, 1))
+	fmt.Println()
+	fmt.Print(writeFrequencies(seq, 2))
+	fmt.Println()
+
+	for _, s := range []string{"GGT", "GGTA", "GGTATT", "GGTATTTTAATT", "GGTATTTTAATTTATAGT"} {
+		fmt.Print(writeCount(seq, s))
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+type Node struct {
+	next  *Node
+	value int
+}
+
+func buildList(n int) *Node {
+	var head *Node
+	for i := 0; i < n; i++ {
+		head = &Node{next: head, value: i}
+	}
+	return head
+}
+
+func sumList(head *Node) int {
+	sum := 0
+	for n := head; n != nil; n = n.next {
+		sum += n.value
+	}
+	return sum
+}
+
+func main() {
+	n := flag.Int("n", 1000000, "number of nodes")
+	flag.Parse()
+
+	head := buildList(*n)
+	fmt.Println(sumList(head))
+}
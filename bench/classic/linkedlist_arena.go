@@ -0,0 +1,92 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+// Node is the linked-list node type backed by an Arena below. It is
+// kept distinct from linkedlist.go's Node so this file can still be
+// run standalone (`go run linkedlist_arena.go`).
+type Node struct {
+	next  *Node
+	value int
+	arena *Arena
+}
+
+// free returns n to the head of its arena's free-list.
+func (n *Node) free() {
+	n.next = n.arena.free
+	n.arena.free = n
+}
+
+// Arena pre-slabs a fixed number of nodes into a single backing slice
+// and hands them out through a free-list, so building and tearing
+// down a list doesn't touch the garbage collector on every node -
+// the same freelist idea binary-trees-freelist.go uses for tree
+// nodes, pulled out here since linkedlist_arena.go is meant to be
+// the reusable version of it.
+type Arena struct {
+	slab []Node
+	free *Node
+}
+
+// NewArena pre-allocates room for 1<<n nodes.
+func NewArena(n uint) *Arena {
+	a := &Arena{slab: make([]Node, 1<<n)}
+	for i := range a.slab {
+		a.slab[i].arena = a
+		a.slab[i].next = a.free
+		a.free = &a.slab[i]
+	}
+	return a
+}
+
+// New hands out the next free node, panicking if the arena is
+// exhausted (the benchmark is expected to size the arena up front).
+func (a *Arena) New(value int) *Node {
+	if a.free == nil {
+		panic("arena: out of nodes")
+	}
+	n := a.free
+	a.free = n.next
+	n.next = nil
+	n.value = value
+	return n
+}
+
+func buildArenaList(a *Arena, n int) *Node {
+	var head *Node
+	for i := 0; i < n; i++ {
+		node := a.New(i)
+		node.next = head
+		head = node
+	}
+	return head
+}
+
+func sumArenaList(head *Node) int {
+	sum := 0
+	for n := head; n != nil; n = n.next {
+		sum += n.value
+	}
+	return sum
+}
+
+func freeArenaList(head *Node) {
+	for n := head; n != nil; {
+		next := n.next
+		n.free()
+		n = next
+	}
+}
+
+func main() {
+	bits := flag.Uint("n", 20, "log2 of arena node capacity")
+	flag.Parse()
+
+	a := NewArena(*bits)
+	head := buildArenaList(a, 1<<*bits)
+	fmt.Println(sumArenaList(head))
+	freeArenaList(head)
+}
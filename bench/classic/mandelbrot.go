@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	n := flag.Int("n", 200, "image size (n x n)")
+	flag.Parse()
+	size := *n
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	fmt.Fprintf(out, "P4\n%d %d\n", size, size)
+
+	const iter = 50
+	const limit = 4.0
+
+	for y := 0; y < size; y++ {
+		ci := (2*float64(y))/float64(size) - 1
+		var bitNum uint8
+		var byteAcc uint8
+		for x := 0; x < size; x++ {
+			cr := (2*float64(x))/float64(size) - 1.5
+
+			var zr, zi float64
+			i := 0
+			for ; i < iter; i++ {
+				zr2 := zr * zr
+				zi2 := zi * zi
+				if zr2+zi2 > limit {
+					break
+				}
+				zi = 2*zr*zi + ci
+				zr = zr2 - zi2 + cr
+			}
+
+			byteAcc <<= 1
+			if i == iter {
+				byteAcc |= 1
+			}
+			bitNum++
+
+			if bitNum == 8 {
+				out.WriteByte(byteAcc)
+				bitNum = 0
+				byteAcc = 0
+			} else if x == size-1 {
+				byteAcc <<= uint(8 - bitNum)
+				out.WriteByte(byteAcc)
+				bitNum = 0
+				byteAcc = 0
+			}
+		}
+	}
+}
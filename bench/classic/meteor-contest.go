@@ -0,0 +1,106 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+// pieces are the fixed polyomino shapes used by this reduced
+// meteor-contest port: each is a list of (dx, dy) cell offsets from
+// the piece's anchor cell. Upstream meteor-contest packs ten
+// pentominoes onto a fixed 5x10 board; reproducing its bit-twiddled
+// solver exactly is out of scope for a benchmark port, so this keeps
+// the same "place every piece exactly once via backtracking search"
+// shape with a small piece set and a board width that can be scaled
+// for benchmarking.
+var pieces = [][][2]int{
+	{{0, 0}, {1, 0}, {2, 0}},         // 3-in-a-row
+	{{0, 0}, {1, 0}, {0, 1}},         // L-tromino
+	{{0, 0}, {0, 1}, {1, 1}},         // S-tromino
+	{{0, 0}, {1, 0}, {1, 1}, {2, 1}}, // Z-tetromino
+}
+
+// solve counts placements of the remaining unused pieces that cover
+// the board's first empty cell, recursing until every piece has been
+// placed. This is the standard "anchor on the first empty cell"
+// technique for counting exact placements without double-counting
+// symmetric orderings.
+func solve(width, height int, board []bool, used []bool, solutions *int) {
+	allUsed := true
+	for _, u := range used {
+		if !u {
+			allUsed = false
+			break
+		}
+	}
+	if allUsed {
+		*solutions++
+		return
+	}
+
+	idx := -1
+	for i, filled := range board {
+		if !filled {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return
+	}
+	x, y := idx%width, idx/width
+
+	for pi, piece := range pieces {
+		if used[pi] {
+			continue
+		}
+		fits := true
+		cells := make([]int, len(piece))
+		for ci, off := range piece {
+			cx, cy := x+off[0], y+off[1]
+			if cx < 0 || cx >= width || cy < 0 || cy >= height {
+				fits = false
+				break
+			}
+			c := cy*width + cx
+			if board[c] {
+				fits = false
+				break
+			}
+			cells[ci] = c
+		}
+		if !fits {
+			continue
+		}
+		for _, c := range cells {
+			board[c] = true
+		}
+		used[pi] = true
+		solve(width, height, board, used, solutions)
+		used[pi] = false
+		for _, c := range cells {
+			board[c] = false
+		}
+	}
+}
+
+// meteor counts the ways to place every piece in pieces exactly once,
+// without overlap and without rotation, onto a width x height board
+// (height is len(pieces)). Only placements in which every cell before
+// each piece's anchor cell (its {0, 0} offset), in row-major order, is
+// covered are counted; placements that leave such a cell empty are
+// not.
+func meteor(width int) int {
+	height := len(pieces)
+	board := make([]bool, width*height)
+	used := make([]bool, len(pieces))
+	solutions := 0
+	solve(width, height, board, used, &solutions)
+	return solutions
+}
+
+func main() {
+	n := flag.Int("n", 6, "board width")
+	flag.Parse()
+	fmt.Println(meteor(*n))
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+// pi_chan is the classic "chain of filter goroutines" concurrent
+// prime sieve (the shape of go/doc/progs/sieve.go), kept alongside
+// the single-threaded sieve in wasm/sieve_bench.go so the two can
+// be compared directly on the same workload (counting/generating
+// primes).
+//
+// Wasm target note: see the scheduler comment in
+// fannkuch-parallel.go. Each filter stage here blocks on a channel
+// send/receive rather than just running to completion, so a
+// single-threaded cooperative scheduler needs to context-switch on
+// those operations specifically (not only on `go`), and the sequence
+// of primes produced is expected to come out in the same order
+// regardless of how the stages are interleaved.
+
+func generate(ch chan<- int) {
+	for i := 2; ; i++ {
+		ch <- i
+	}
+}
+
+func filter(in <-chan int, out chan<- int, prime int) {
+	for {
+		i := <-in
+		if i%prime != 0 {
+			out <- i
+		}
+	}
+}
+
+func sievePrimes(count int) []int {
+	primes := make([]int, 0, count)
+	ch := make(chan int)
+	go generate(ch)
+	for i := 0; i < count; i++ {
+		prime := <-ch
+		primes = append(primes, prime)
+		ch1 := make(chan int)
+		go filter(ch, ch1, prime)
+		ch = ch1
+	}
+	return primes
+}
+
+func main() {
+	n := flag.Int("n", 100, "number of primes to generate")
+	flag.Parse()
+
+	primes := sievePrimes(*n)
+	fmt.Println(primes[len(primes)-1])
+}
@@ -0,0 +1,36 @@
+package main
+
+import "testing"
+
+// Run with: go test pi_chan.go pi_chan_test.go
+
+// TestSievePrimesDeterministic guards the generate/filter goroutine
+// pipeline: the i-th prime out of the chain must not depend on how
+// the stages happen to get scheduled.
+func TestSievePrimesDeterministic(t *testing.T) {
+	want := sievePrimes(100)
+	for i := 0; i < 20; i++ {
+		got := sievePrimes(100)
+		if len(got) != len(want) {
+			t.Fatalf("run %d: got %d primes, want %d", i, len(got), len(want))
+		}
+		for j := range want {
+			if got[j] != want[j] {
+				t.Fatalf("run %d: primes[%d] = %d, want %d", i, j, got[j], want[j])
+			}
+		}
+	}
+}
+
+func TestSievePrimesKnownValues(t *testing.T) {
+	got := sievePrimes(10)
+	want := []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}
+	if len(got) != len(want) {
+		t.Fatalf("sievePrimes(10) = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sievePrimes(10)[%d] = %d, want %d", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/big"
+)
+
+var (
+	tmp1 = big.NewInt(0)
+	tmp2 = big.NewInt(0)
+	acc  = big.NewInt(0)
+	den  = big.NewInt(1)
+	num  = big.NewInt(1)
+)
+
+func extractDigit(nth int64) int64 {
+	if num.Cmp(acc) > 0 {
+		return -1
+	}
+	tmp1.Mul(num, big.NewInt(nth))
+	tmp1.Add(tmp1, acc)
+	tmp2.Div(tmp1, den)
+	return tmp2.Int64()
+}
+
+func eliminateDigit(d int64) {
+	acc.Sub(acc, tmp2.Mul(den, big.NewInt(d)))
+	acc.Mul(acc, big.NewInt(10))
+	num.Mul(num, big.NewInt(10))
+}
+
+func nextTerm(k int64) {
+	k2 := k*2 + 1
+	acc.Add(acc, tmp2.Mul(num, big.NewInt(2)))
+	acc.Mul(acc, big.NewInt(k2))
+	den.Mul(den, big.NewInt(k2))
+	num.Mul(num, big.NewInt(k))
+}
+
+// pidigits is the unbounded spigot algorithm for the digits of pi
+// over math/big.Int, the benchmark that exercises big-int codegen
+// under the wasm backend.
+func main() {
+	n := flag.Int64("n", 27, "number of pi digits")
+	flag.Parse()
+
+	var i, k int64
+	for i < *n {
+		k++
+		nextTerm(k)
+		if num.Cmp(acc) > 0 {
+			continue
+		}
+		d := extractDigit(3)
+		if d != extractDigit(4) {
+			continue
+		}
+		fmt.Printf("%d", d)
+		i++
+		if i%10 == 0 {
+			fmt.Printf("\t:%d\n", i)
+		}
+		eliminateDigit(d)
+	}
+}
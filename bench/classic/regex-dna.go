@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+)
+
+var variants = []string{
+	"agggtaaa|tttaccct",
+	"[cgt]gggtaaa|tttaccc[acg]",
+	"a[act]ggtaaa|tttacc[agt]t",
+	"ag[act]gtaaa|tttac[agt]ct",
+	"agg[act]taaa|ttta[agt]cct",
+	"aggg[acg]aaa|ttt[cgt]ccct",
+	"agggt[cgt]aa|tt[acg]accct",
+	"agggta[cgt]a|t[acg]taccct",
+	"agggtaa[cgt]|[acg]ttaccct",
+}
+
+var substitutions = [][2]string{
+	{"B", "(c|g|t)"}, {"D", "(a|g|t)"}, {"H", "(a|c|t)"}, {"K", "(g|t)"},
+	{"M", "(a|c)"}, {"N", "(a|c|g|t)"}, {"R", "(a|g)"}, {"S", "(c|g)"},
+	{"V", "(a|c|g)"}, {"W", "(a|t)"}, {"Y", "(c|t)"},
+}
+
+// regex-dna reads a FASTA file from stdin, counts matches for nine
+// IUPAC patterns via regexp, then substitutes each IUPAC ambiguity
+// code for its expansion and reports the sequence length before and
+// after. It's the benchmark that exercises regexp compilation and
+// matching under the wasm backend; k-nucleotide.go and fasta.go cover
+// map[string]int and random-sequence generation instead.
+func main() {
+	input, err := ioutil.ReadAll(bufio.NewReader(os.Stdin))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	ilen := len(input)
+
+	header := regexp.MustCompile("(>[^\n]*)?\n")
+	input = header.ReplaceAll(input, []byte{})
+	clen := len(input)
+
+	for _, s := range variants {
+		re := regexp.MustCompile(s)
+		n := len(re.FindAllIndex(input, -1))
+		fmt.Printf("%s %d\n", s, n)
+	}
+
+	for _, sub := range substitutions {
+		re := regexp.MustCompile(sub[0])
+		input = re.ReplaceAll(input, []byte(sub[1]))
+	}
+
+	fmt.Printf("\n%d\n%d\n%d\n", ilen, clen, len(input))
+}
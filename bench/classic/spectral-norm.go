@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+)
+
+func A(i, j int) float64 {
+	return 1 / float64((i+j)*(i+j+1)/2+i+1)
+}
+
+func multiplyAv(v, out []float64) {
+	n := len(v)
+	for i := 0; i < n; i++ {
+		var sum float64
+		for j := 0; j < n; j++ {
+			sum += A(i, j) * v[j]
+		}
+		out[i] = sum
+	}
+}
+
+func multiplyAtv(v, out []float64) {
+	n := len(v)
+	for i := 0; i < n; i++ {
+		var sum float64
+		for j := 0; j < n; j++ {
+			sum += A(j, i) * v[j]
+		}
+		out[i] = sum
+	}
+}
+
+func multiplyAtAv(v, out, tmp []float64) {
+	multiplyAv(v, tmp)
+	multiplyAtv(tmp, out)
+}
+
+func spectralNorm(n int) float64 {
+	u := make([]float64, n)
+	v := make([]float64, n)
+	tmp := make([]float64, n)
+	for i := range u {
+		u[i] = 1
+	}
+
+	for i := 0; i < 10; i++ {
+		multiplyAtAv(u, v, tmp)
+		multiplyAtAv(v, u, tmp)
+	}
+
+	var vBv, vv float64
+	for i := 0; i < n; i++ {
+		vBv += u[i] * v[i]
+		vv += v[i] * v[i]
+	}
+	return math.Sqrt(vBv / vv)
+}
+
+func main() {
+	n := flag.Int("n", 100, "matrix size")
+	flag.Parse()
+	fmt.Printf("%.9f\n", spectralNorm(*n))
+}
@@ -0,0 +1,142 @@
+// Command run drives every *_bench wasm export under a wasm runtime,
+// checks its result against a checked-in golden value, and appends
+// timing data to timing.log.
+//
+// It is the wasm-side counterpart to upstream Go's
+// test/bench/timing.sh: where timing.sh shells out to natively
+// compiled Go binaries, this drives the same benchmarks compiled to
+// wasm, so ClojureWasm codegen changes can be bisected against both
+// correctness (the golden check) and measured performance (the
+// timing.log append) in one run.
+//
+// Usage:
+//
+//	make run
+//
+// which builds every bench/wasm/*_bench.go to wasm/<name>.wasm via
+// tinygo and then runs this harness; see Makefile. To run the harness
+// directly against wasm artifacts already built:
+//
+//	go run run.go -commit=$(git rev-parse --short HEAD) -date=$(date -u +%FT%TZ)
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// bench describes one *_bench wasm export: where to find its
+// compiled module, which function to call, what arguments to pass,
+// and the golden result that must come back for the run to count as
+// a pass. Negative int32 results (nbody_bench) are encoded as their
+// zero-extended uint32 bit pattern, matching how wazero reports i32
+// return values, so golden can stay a plain uint64 with no
+// "0 means skip" special case.
+type bench struct {
+	name   string
+	wasm   string
+	export string
+	args   []uint64
+	golden uint64
+}
+
+// benches is the canonical list this harness drives. Sizes and
+// iteration counts are chosen to run in well under a second per bench
+// on a debug-build wasm runtime. Golden values were captured from a
+// known-good interpreter run and should only change alongside an
+// intentional algorithm change to the corresponding bench.
+var benches = []bench{
+	{"fib_bench", "wasm/fib.wasm", "fib_bench", []uint64{25, 5}, 121393},
+	{"sieve_bench", "wasm/sieve.wasm", "sieve_bench", []uint64{10000, 5}, 1229},
+	{"tak_bench", "wasm/tak.wasm", "tak_bench", []uint64{24, 16, 8, 5}, 9},
+	{"arith_bench", "wasm/arith.wasm", "arith_bench", []uint64{100000, 5}, 4999950000},
+	{"gcd_bench", "wasm/gcd.wasm", "gcd_bench", []uint64{48, 18, 5}, 22},
+	{"fib_loop_bench", "wasm/fib_loop.wasm", "fib_loop_bench", []uint64{30, 5}, 832040},
+	{"binary_tree_bench", "wasm/binary_tree.wasm", "binary_tree_bench", []uint64{12, 5}, 16383},
+	{"binary_tree_freelist_bench", "wasm/binary_tree_freelist.wasm", "binary_tree_freelist_bench", []uint64{12, 5}, 16383},
+	{"linked_list_bench", "wasm/linked_list.wasm", "linked_list_bench", []uint64{1000, 5}, 499500},
+	{"linked_list_arena_bench", "wasm/linked_list_arena.wasm", "linked_list_arena_bench", []uint64{1000, 5}, 499500},
+	{"fannkuch_bench", "wasm/fannkuch.wasm", "fannkuch_bench", []uint64{7, 3}, 228},
+	{"nbody_bench", "wasm/nbody.wasm", "nbody_bench", []uint64{1000, 3}, 4294798209}, // -169087 as uint32
+	{"mandelbrot_bench", "wasm/mandelbrot.wasm", "mandelbrot_bench", []uint64{200, 3}, 15909},
+	{"k_nucleotide_bench", "wasm/k_nucleotide.wasm", "k_nucleotide_bench", []uint64{10000, 3}, 3467},
+	{"fasta_bench", "wasm/fasta.wasm", "fasta_bench", []uint64{10000, 3}, 1045140},
+	{"spectral_norm_bench", "wasm/spectral_norm.wasm", "spectral_norm_bench", []uint64{100, 3}, 1274219},
+	{"meteor_bench", "wasm/meteor.wasm", "meteor_bench", []uint64{6, 3}, 2},
+	{"regex_dna_bench", "wasm/regex_dna.wasm", "regex_dna_bench", []uint64{3}, 5},
+	{"pidigits_bench", "wasm/pidigits.wasm", "pidigits_bench", []uint64{27}, 129},
+}
+
+func runBench(ctx context.Context, rt wazero.Runtime, b bench) (uint64, time.Duration, error) {
+	wasmBytes, err := os.ReadFile(b.wasm)
+	if err != nil {
+		return 0, 0, fmt.Errorf("read %s: %w", b.wasm, err)
+	}
+
+	mod, err := rt.Instantiate(ctx, wasmBytes)
+	if err != nil {
+		return 0, 0, fmt.Errorf("instantiate %s: %w", b.wasm, err)
+	}
+	defer mod.Close(ctx)
+
+	fn := mod.ExportedFunction(b.export)
+	if fn == nil {
+		return 0, 0, fmt.Errorf("%s: no exported function %q", b.wasm, b.export)
+	}
+
+	start := time.Now()
+	out, err := fn.Call(ctx, b.args...)
+	elapsed := time.Since(start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("call %s: %w", b.export, err)
+	}
+	return out[0], elapsed, nil
+}
+
+func main() {
+	commit := flag.String("commit", "", "commit hash to record in timing.log")
+	date := flag.String("date", "", "date to record in timing.log (RFC3339)")
+	flag.Parse()
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+	wasi_snapshot_preview1.MustInstantiate(ctx, rt)
+
+	logFile, err := os.OpenFile("timing.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer logFile.Close()
+
+	failed := false
+	for _, b := range benches {
+		got, elapsed, err := runBench(ctx, rt, b)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", b.name, err)
+			failed = true
+			continue
+		}
+		if got != b.golden {
+			fmt.Fprintf(os.Stderr, "%s: got %d, want %d\n", b.name, got, b.golden)
+			failed = true
+			continue
+		}
+
+		fmt.Printf("%-28s %12d ns/op   result=%d\n", b.name, elapsed.Nanoseconds(), got)
+		// bytes/op is left at 0 until allocation tracking is wired
+		// through wazero's memory growth callbacks.
+		fmt.Fprintf(logFile, "%s\t%s\t%s\t%d\t%d\n", *commit, *date, b.name, elapsed.Nanoseconds(), 0)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+type btNode struct {
+	left, right *btNode
+}
+
+//go:noinline
+func btBottomUpTree(depth int32) *btNode {
+	if depth <= 0 {
+		return &btNode{}
+	}
+	return &btNode{btBottomUpTree(depth - 1), btBottomUpTree(depth - 1)}
+}
+
+func (n *btNode) itemCheck() int32 {
+	if n.left == nil {
+		return 1
+	}
+	return 1 + n.left.itemCheck() + n.right.itemCheck()
+}
+
+//go:noinline
+//export binary_tree
+func binary_tree(depth int32) int32 {
+	return btBottomUpTree(depth).itemCheck()
+}
+
+// binary_tree_bench builds and checks a depth-`n` tree `iterations`
+// times, varying depth slightly to prevent constant folding. Returns
+// the last checksum.
+//export binary_tree_bench
+func binary_tree_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = binary_tree(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}
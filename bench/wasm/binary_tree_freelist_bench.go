@@ -0,0 +1,55 @@
+package main
+
+type flNode struct {
+	left, right *flNode
+}
+
+type freelist struct {
+	nodes []flNode
+	next  int32
+}
+
+func (f *freelist) alloc() *flNode {
+	n := &f.nodes[f.next]
+	f.next++
+	return n
+}
+
+//go:noinline
+func flBottomUpTree(f *freelist, depth int32) *flNode {
+	n := f.alloc()
+	if depth > 0 {
+		n.left = flBottomUpTree(f, depth-1)
+		n.right = flBottomUpTree(f, depth-1)
+	}
+	return n
+}
+
+func (n *flNode) itemCheck() int32 {
+	if n.left == nil {
+		return 1
+	}
+	return 1 + n.left.itemCheck() + n.right.itemCheck()
+}
+
+//go:noinline
+//export binary_tree_freelist
+func binary_tree_freelist(depth int32) int32 {
+	nodeCount := (int32(1) << uint(depth+1)) - 1
+	f := &freelist{nodes: make([]flNode, nodeCount)}
+	return flBottomUpTree(f, depth).itemCheck()
+}
+
+// binary_tree_freelist_bench runs binary_tree_freelist(n) `iterations`
+// times, varying depth slightly to prevent constant folding. Returns
+// the last checksum.
+//export binary_tree_freelist_bench
+func binary_tree_freelist_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = binary_tree_freelist(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}
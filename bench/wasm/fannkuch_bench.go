@@ -0,0 +1,65 @@
+package main
+
+//go:noinline
+func fkFlip(p []int32) int32 {
+	var count int32
+	perm := append([]int32(nil), p...)
+	for perm[0] != 0 {
+		k := perm[0]
+		for i, j := int32(0), k; i < j; i, j = i+1, j-1 {
+			perm[i], perm[j] = perm[j], perm[i]
+		}
+		count++
+	}
+	return count
+}
+
+// fannkuch returns the fannkuch-redux checksum (the signed sum of
+// flip counts over all n! permutations) for permutations of size n.
+//go:noinline
+//export fannkuch
+func fannkuch(n int32) int32 {
+	perm := make([]int32, n)
+	for i := range perm {
+		perm[i] = int32(i)
+	}
+
+	var checksum, idx int32
+	var permute func(k int32)
+	permute = func(k int32) {
+		if k == 1 {
+			f := fkFlip(perm)
+			if idx%2 == 0 {
+				checksum += f
+			} else {
+				checksum -= f
+			}
+			idx++
+			return
+		}
+		for i := int32(0); i < k; i++ {
+			permute(k - 1)
+			if k%2 == 0 {
+				perm[i], perm[k-1] = perm[k-1], perm[i]
+			} else {
+				perm[0], perm[k-1] = perm[k-1], perm[0]
+			}
+		}
+	}
+	permute(n)
+	return checksum
+}
+
+// fannkuch_bench runs fannkuch(n) `iterations` times, varying input
+// slightly to prevent constant folding. Returns the last checksum.
+// Keep n small (<=8) since cost grows factorially.
+//export fannkuch_bench
+func fannkuch_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = fannkuch(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}
@@ -0,0 +1,60 @@
+package main
+
+const (
+	faIm = 139968
+	faIa = 3877
+	faIc = 29573
+)
+
+type faAcid struct {
+	c    byte
+	prob float64
+}
+
+func faCumulative(acids []faAcid) []faAcid {
+	var sum float64
+	out := make([]faAcid, len(acids))
+	for i, a := range acids {
+		sum += a.prob
+		out[i] = faAcid{a.c, sum}
+	}
+	return out
+}
+
+var faHomoSapiens = faCumulative([]faAcid{
+	{'a', 0.3029549426680}, {'c', 0.1979883004921},
+	{'g', 0.1975473066391}, {'t', 0.3015094502008},
+})
+
+// fasta generates n weighted-random bases using the homo-sapiens
+// frequency table from fasta.go's generator and returns the sum of
+// the generated byte values as a checksum.
+//go:noinline
+//export fasta
+func fasta(n int32) int32 {
+	seed := int32(42)
+	var checksum int32
+	for i := int32(0); i < n; i++ {
+		seed = (seed*faIa + faIc) % faIm
+		r := float64(seed) / faIm
+		j := 0
+		for faHomoSapiens[j].prob < r {
+			j++
+		}
+		checksum += int32(faHomoSapiens[j].c)
+	}
+	return checksum
+}
+
+// fasta_bench runs fasta(n) `iterations` times, varying input
+// slightly to prevent constant folding. Returns the last checksum.
+//export fasta_bench
+func fasta_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = fasta(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}
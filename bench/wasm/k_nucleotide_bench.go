@@ -0,0 +1,67 @@
+package main
+
+const (
+	knIm = 139968
+	knIa = 3877
+	knIc = 29573
+)
+
+func knGenerate(n int32, seed *int32) []byte {
+	seq := make([]byte, n)
+	bases := [4]byte{'A', 'C', 'G', 'T'}
+	for i := int32(0); i < n; i++ {
+		*seed = (*seed*knIa + knIc) % knIm
+		// *seed%4 would pick the base from seed's low bits alone,
+		// which degenerates here: knIa and knIc are both 1 mod 4, so
+		// *seed%4 just advances by a fixed +1 each step instead of
+		// varying. Bucketing the full [0,knIm) range into quarters
+		// uses the high bits instead, so the sequence is actually
+		// irregular.
+		seq[i] = bases[*seed*4/knIm]
+	}
+	return seq
+}
+
+func knCount(seq []byte, k int32) int32 {
+	counts := make(map[string]int32)
+	for i := int32(0); i+k <= int32(len(seq)); i++ {
+		counts[string(seq[i:i+k])]++
+	}
+	var maxCount int32
+	for _, c := range counts {
+		if c > maxCount {
+			maxCount = c
+		}
+	}
+	return maxCount
+}
+
+// k_nucleotide generates a pseudo-random n-base DNA sequence and
+// returns the sum of the highest frequency observed among its
+// distinct 1-mers through 4-mers, exercising string slicing and
+// map[string]int32 under the wasm backend.
+//go:noinline
+//export k_nucleotide
+func k_nucleotide(n int32) int32 {
+	seed := int32(42)
+	seq := knGenerate(n, &seed)
+	var checksum int32
+	for k := int32(1); k <= 4; k++ {
+		checksum += knCount(seq, k)
+	}
+	return checksum
+}
+
+// k_nucleotide_bench runs k_nucleotide(n) `iterations` times, varying
+// input slightly to prevent constant folding. Returns the last
+// checksum.
+//export k_nucleotide_bench
+func k_nucleotide_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = k_nucleotide(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}
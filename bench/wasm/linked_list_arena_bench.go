@@ -0,0 +1,76 @@
+package main
+
+// laNode/laArena are the wasm-side counterpart of linkedlist_arena.go's
+// Node/Arena: a free-list-backed node pool sized up front so the list
+// can be built and torn down without allocating per node.
+type laNode struct {
+	next  *laNode
+	value int32
+	arena *laArena
+}
+
+func (n *laNode) free() {
+	n.next = n.arena.free
+	n.arena.free = n
+}
+
+type laArena struct {
+	slab []laNode
+	free *laNode
+}
+
+func newLaArena(n int32) *laArena {
+	a := &laArena{slab: make([]laNode, n)}
+	for i := range a.slab {
+		a.slab[i].arena = a
+		a.slab[i].next = a.free
+		a.free = &a.slab[i]
+	}
+	return a
+}
+
+func (a *laArena) new(value int32) *laNode {
+	n := a.free
+	a.free = n.next
+	n.next = nil
+	n.value = value
+	return n
+}
+
+//go:noinline
+//export linked_list_arena
+func linked_list_arena(n int32) int32 {
+	a := newLaArena(n)
+	var head *laNode
+	for i := int32(0); i < n; i++ {
+		node := a.new(i)
+		node.next = head
+		head = node
+	}
+
+	var sum int32
+	for cur := head; cur != nil; cur = cur.next {
+		sum += cur.value
+	}
+
+	for cur := head; cur != nil; {
+		next := cur.next
+		cur.free()
+		cur = next
+	}
+	return sum
+}
+
+// linked_list_arena_bench builds and sums an n-node list `iterations`
+// times, varying n slightly to prevent constant folding. Returns the
+// last sum.
+//export linked_list_arena_bench
+func linked_list_arena_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = linked_list_arena(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}
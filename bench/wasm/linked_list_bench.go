@@ -0,0 +1,43 @@
+package main
+
+type llNode struct {
+	next  *llNode
+	value int32
+}
+
+//go:noinline
+func llBuild(n int32) *llNode {
+	var head *llNode
+	for i := int32(0); i < n; i++ {
+		head = &llNode{next: head, value: i}
+	}
+	return head
+}
+
+func llSum(head *llNode) int32 {
+	var sum int32
+	for n := head; n != nil; n = n.next {
+		sum += n.value
+	}
+	return sum
+}
+
+//go:noinline
+//export linked_list
+func linked_list(n int32) int32 {
+	return llSum(llBuild(n))
+}
+
+// linked_list_bench builds and sums an n-node list `iterations` times,
+// varying n slightly to prevent constant folding. Returns the last
+// sum. See linked_list_arena_bench.go for the arena-backed variant.
+//export linked_list_bench
+func linked_list_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = linked_list(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}
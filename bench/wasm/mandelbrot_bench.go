@@ -0,0 +1,49 @@
+package main
+
+// mandelbrot returns the number of size x size grid points that stay
+// bounded (are "in the set") after 50 iterations, exercising float64
+// arithmetic under the wasm backend the way mandelbrot.go's PBM
+// output does for a real renderer.
+//go:noinline
+//export mandelbrot
+func mandelbrot(size int32) int32 {
+	const iter = 50
+	const limit = 4.0
+
+	var inSet int32
+	for y := int32(0); y < size; y++ {
+		ci := (2*float64(y))/float64(size) - 1
+		for x := int32(0); x < size; x++ {
+			cr := (2*float64(x))/float64(size) - 1.5
+			var zr, zi float64
+			i := int32(0)
+			for ; i < iter; i++ {
+				zr2 := zr * zr
+				zi2 := zi * zi
+				if zr2+zi2 > limit {
+					break
+				}
+				zi = 2*zr*zi + ci
+				zr = zr2 - zi2 + cr
+			}
+			if i == iter {
+				inSet++
+			}
+		}
+	}
+	return inSet
+}
+
+// mandelbrot_bench renders a size-`n` mandelbrot set `iterations`
+// times, varying size slightly to prevent constant folding. Returns
+// the last in-set pixel count.
+//export mandelbrot_bench
+func mandelbrot_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = mandelbrot(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}
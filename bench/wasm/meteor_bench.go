@@ -0,0 +1,100 @@
+package main
+
+// meteorPieces mirrors the reduced piece set from meteor-contest.go;
+// see that file for why it isn't the full ten-pentomino puzzle.
+var meteorPieces = [][][2]int32{
+	{{0, 0}, {1, 0}, {2, 0}},
+	{{0, 0}, {1, 0}, {0, 1}},
+	{{0, 0}, {0, 1}, {1, 1}},
+	{{0, 0}, {1, 0}, {1, 1}, {2, 1}},
+}
+
+func meteorSolve(width int32, board []bool, used []bool, solutions *int32) {
+	allUsed := true
+	for _, u := range used {
+		if !u {
+			allUsed = false
+			break
+		}
+	}
+	if allUsed {
+		*solutions++
+		return
+	}
+
+	height := int32(len(meteorPieces))
+	idx := int32(-1)
+	for i, filled := range board {
+		if !filled {
+			idx = int32(i)
+			break
+		}
+	}
+	if idx == -1 {
+		return
+	}
+	x, y := idx%width, idx/width
+
+	for pi, piece := range meteorPieces {
+		if used[pi] {
+			continue
+		}
+		fits := true
+		cells := make([]int32, len(piece))
+		for ci, off := range piece {
+			cx, cy := x+off[0], y+off[1]
+			if cx < 0 || cx >= width || cy < 0 || cy >= height {
+				fits = false
+				break
+			}
+			c := cy*width + cx
+			if board[c] {
+				fits = false
+				break
+			}
+			cells[ci] = c
+		}
+		if !fits {
+			continue
+		}
+		for _, c := range cells {
+			board[c] = true
+		}
+		used[pi] = true
+		meteorSolve(width, board, used, solutions)
+		used[pi] = false
+		for _, c := range cells {
+			board[c] = false
+		}
+	}
+}
+
+// meteor counts the ways to place every piece in meteorPieces exactly
+// once, without overlap and without rotation, onto a width x 4 board.
+// Only placements in which every cell before each piece's anchor cell
+// (its {0, 0} offset), in row-major order, is covered are counted;
+// placements that leave such a cell empty are not.
+//go:noinline
+//export meteor
+func meteor(width int32) int32 {
+	height := int32(len(meteorPieces))
+	board := make([]bool, width*height)
+	used := make([]bool, len(meteorPieces))
+	var solutions int32
+	meteorSolve(width, board, used, &solutions)
+	return solutions
+}
+
+// meteor_bench runs meteor(n) `iterations` times, varying input
+// slightly to prevent constant folding. Returns the last solution
+// count.
+//export meteor_bench
+func meteor_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = meteor(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}
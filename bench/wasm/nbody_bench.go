@@ -0,0 +1,115 @@
+package main
+
+import "math"
+
+const (
+	nbSolarMass   = 4 * math.Pi * math.Pi
+	nbDaysPerYear = 365.24
+)
+
+type nbBody struct {
+	x, y, z    float64
+	vx, vy, vz float64
+	mass       float64
+}
+
+func nbBodies() []nbBody {
+	return []nbBody{
+		{0, 0, 0, 0, 0, 0, nbSolarMass},
+		{4.84143144246472090e+00, -1.16032004402742839e+00, -1.03622044471123109e-01,
+			1.66007664274403694e-03 * nbDaysPerYear, 7.69901118419740425e-03 * nbDaysPerYear, -6.90460016972063023e-05 * nbDaysPerYear,
+			9.54791938424326609e-04 * nbSolarMass},
+		{8.34336671824457987e+00, 4.12479856412430479e+00, -4.03523417114321381e-01,
+			-2.76742510726862411e-03 * nbDaysPerYear, 4.99852801234917238e-03 * nbDaysPerYear, 2.30417297573763929e-05 * nbDaysPerYear,
+			2.85885980666130812e-04 * nbSolarMass},
+		{1.28943695621391310e+01, -1.51111514016986312e+01, -2.23307578892655734e-01,
+			2.96460137564761618e-03 * nbDaysPerYear, 2.37847173959480950e-03 * nbDaysPerYear, -2.96589568540237556e-05 * nbDaysPerYear,
+			4.36624404335156298e-05 * nbSolarMass},
+		{1.53796971148509165e+01, -2.59193146099879641e+01, 1.79258772950371181e-01,
+			2.68067772490389322e-03 * nbDaysPerYear, 1.62824170038242295e-03 * nbDaysPerYear, -9.51592254519715870e-05 * nbDaysPerYear,
+			5.15138902046611451e-05 * nbSolarMass},
+	}
+}
+
+func nbOffsetMomentum(bodies []nbBody) {
+	var px, py, pz float64
+	for _, b := range bodies {
+		px += b.vx * b.mass
+		py += b.vy * b.mass
+		pz += b.vz * b.mass
+	}
+	bodies[0].vx = -px / nbSolarMass
+	bodies[0].vy = -py / nbSolarMass
+	bodies[0].vz = -pz / nbSolarMass
+}
+
+func nbAdvance(bodies []nbBody, dt float64) {
+	n := len(bodies)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			dx := bodies[i].x - bodies[j].x
+			dy := bodies[i].y - bodies[j].y
+			dz := bodies[i].z - bodies[j].z
+			dSquared := dx*dx + dy*dy + dz*dz
+			distance := math.Sqrt(dSquared)
+			mag := dt / (dSquared * distance)
+
+			bodies[i].vx -= dx * bodies[j].mass * mag
+			bodies[i].vy -= dy * bodies[j].mass * mag
+			bodies[i].vz -= dz * bodies[j].mass * mag
+
+			bodies[j].vx += dx * bodies[i].mass * mag
+			bodies[j].vy += dy * bodies[i].mass * mag
+			bodies[j].vz += dz * bodies[i].mass * mag
+		}
+	}
+	for i := range bodies {
+		bodies[i].x += dt * bodies[i].vx
+		bodies[i].y += dt * bodies[i].vy
+		bodies[i].z += dt * bodies[i].vz
+	}
+}
+
+func nbEnergy(bodies []nbBody) float64 {
+	var e float64
+	n := len(bodies)
+	for i := 0; i < n; i++ {
+		e += 0.5 * bodies[i].mass * (bodies[i].vx*bodies[i].vx + bodies[i].vy*bodies[i].vy + bodies[i].vz*bodies[i].vz)
+		for j := i + 1; j < n; j++ {
+			dx := bodies[i].x - bodies[j].x
+			dy := bodies[i].y - bodies[j].y
+			dz := bodies[i].z - bodies[j].z
+			distance := math.Sqrt(dx*dx + dy*dy + dz*dz)
+			e -= bodies[i].mass * bodies[j].mass / distance
+		}
+	}
+	return e
+}
+
+// nbody advances the standard five-body solar-system simulation
+// `steps` times and returns the final energy scaled by 1e6 and
+// truncated to int32, since these wasm exports only deal in
+// int32/int64.
+//go:noinline
+//export nbody
+func nbody(steps int32) int32 {
+	bodies := nbBodies()
+	nbOffsetMomentum(bodies)
+	for i := int32(0); i < steps; i++ {
+		nbAdvance(bodies, 0.01)
+	}
+	return int32(nbEnergy(bodies) * 1e6)
+}
+
+// nbody_bench runs nbody(n) `iterations` times, varying input
+// slightly to prevent constant folding. Returns the last result.
+//export nbody_bench
+func nbody_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = nbody(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}
@@ -0,0 +1,46 @@
+package main
+
+import "regexp"
+
+// rdSeq is a small fixed DNA-like string (with a few IUPAC ambiguity
+// codes spliced in, same as a real FASTA file would have) so this
+// export can run without stdin. regex-dna.go in bench/classic runs
+// the same patterns at a realistic FASTA-file scale.
+const rdSeq = "agggtaaaagggtaaaBDHKMNRSVWYagggtaaatttaccctagggtaaa"
+
+var rdVariants = []string{
+	"agggtaaa|tttaccct",
+	"[cgt]gggtaaa|tttaccc[acg]",
+	"a[act]ggtaaa|tttacc[agt]t",
+	"ag[act]gtaaa|tttac[agt]ct",
+	"agg[act]taaa|ttta[agt]cct",
+	"aggg[acg]aaa|ttt[cgt]ccct",
+	"agggt[cgt]aa|tt[acg]accct",
+	"agggta[cgt]a|t[acg]taccct",
+	"agggtaa[cgt]|[acg]ttaccct",
+}
+
+// regex_dna compiles and runs the nine IUPAC shootout patterns
+// against rdSeq and returns the sum of match counts, exercising
+// regexp compilation and matching under the wasm backend.
+func regex_dna() int32 {
+	var total int32
+	for _, pattern := range rdVariants {
+		re := regexp.MustCompile(pattern)
+		total += int32(len(re.FindAllStringIndex(rdSeq, -1)))
+	}
+	return total
+}
+
+// regex_dna_bench runs regex_dna `iterations` times. Returns the
+// last result.
+//export regex_dna_bench
+func regex_dna_bench(iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = regex_dna()
+	}
+	return result
+}
+
+func main() {}
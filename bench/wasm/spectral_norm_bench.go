@@ -0,0 +1,72 @@
+package main
+
+import "math"
+
+func snA(i, j int32) float64 {
+	return 1 / float64((i+j)*(i+j+1)/2+i+1)
+}
+
+func snMultiplyAv(v, out []float64) {
+	n := int32(len(v))
+	for i := int32(0); i < n; i++ {
+		var sum float64
+		for j := int32(0); j < n; j++ {
+			sum += snA(i, j) * v[j]
+		}
+		out[i] = sum
+	}
+}
+
+func snMultiplyAtv(v, out []float64) {
+	n := int32(len(v))
+	for i := int32(0); i < n; i++ {
+		var sum float64
+		for j := int32(0); j < n; j++ {
+			sum += snA(j, i) * v[j]
+		}
+		out[i] = sum
+	}
+}
+
+func snMultiplyAtAv(v, out, tmp []float64) {
+	snMultiplyAv(v, tmp)
+	snMultiplyAtv(tmp, out)
+}
+
+// spectral_norm returns the approximate spectral norm (scaled by 1e6
+// and truncated to int32) of the n x n matrix used by
+// spectral-norm.go, computed via ten power-method iterations.
+//go:noinline
+//export spectral_norm
+func spectral_norm(n int32) int32 {
+	u := make([]float64, n)
+	v := make([]float64, n)
+	tmp := make([]float64, n)
+	for i := range u {
+		u[i] = 1
+	}
+	for i := 0; i < 10; i++ {
+		snMultiplyAtAv(u, v, tmp)
+		snMultiplyAtAv(v, u, tmp)
+	}
+	var vBv, vv float64
+	for i := int32(0); i < n; i++ {
+		vBv += u[i] * v[i]
+		vv += v[i] * v[i]
+	}
+	return int32(math.Sqrt(vBv/vv) * 1e6)
+}
+
+// spectral_norm_bench runs spectral_norm(n) `iterations` times,
+// varying input slightly to prevent constant folding. Returns the
+// last result.
+//export spectral_norm_bench
+func spectral_norm_bench(n int32, iterations int32) int32 {
+	var result int32
+	for i := int32(0); i < iterations; i++ {
+		result = spectral_norm(n + (result & 1))
+	}
+	return result
+}
+
+func main() {}